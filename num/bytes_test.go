@@ -0,0 +1,72 @@
+package num
+
+import (
+	"math/big"
+	"testing"
+
+	"golang.org/x/text/language"
+)
+
+func TestBytesFormatterFormat(t *testing.T) {
+
+	cases := []struct {
+		n       int64
+		f       BytesFormatter
+		want    string
+		wantErr bool
+	}{
+		{-1, BytesFormatter{}, "", true},
+
+		{10, BytesFormatter{Precision: 1}, "10B", false},
+		{2000000, BytesFormatter{Precision: 1}, "1.9MB", false},
+		{2000000, BytesFormatter{Suffix: SuffixIEC, Precision: 1}, "1.9MiB", false},
+		{2000000, BytesFormatter{Suffix: SuffixLong, Precision: 1}, "1.9 megabytes", false},
+		{2000000, BytesFormatter{Base: Decimal1000, Precision: 1}, "2.0MB", false},
+		{2000000, BytesFormatter{Precision: 1, Lang: language.German}, "1,9MB", false},
+
+		// Values past the largest configured unit (petabytes)
+		// stay at that unit and so can grow past 1000, at
+		// which point digit grouping kicks in.
+		{2251799813685248000, BytesFormatter{Precision: 1}, "2,000.0PB", false},
+		{2251799813685248000, BytesFormatter{Precision: 1, Lang: language.German}, "2.000,0PB", false},
+	}
+
+	for _, c := range cases {
+		got, err := c.f.FormatInt64(c.n)
+		if got != c.want || err == nil && c.wantErr {
+
+			errStr := "nil"
+			if c.wantErr {
+				errStr = "error"
+			}
+
+			t.Errorf("BytesFormatter{...}.FormatInt64(%d)\n"+
+				"    return %q, %v\n"+
+				"    wanted %q, %s\n",
+				c.n, got, err, c.want, errStr)
+		}
+	}
+}
+
+func TestBytesFormatterFormatUint64(t *testing.T) {
+	f := BytesFormatter{Precision: 1}
+	got, err := f.FormatUint64(2000000)
+	if err != nil || got != "1.9MB" {
+		t.Errorf("BytesFormatter{...}.FormatUint64(2000000)\n"+
+			"    return %q, %v\n"+
+			"    wanted %q, nil\n",
+			got, err, "1.9MB")
+	}
+}
+
+func TestBytesFormatterFormatBig(t *testing.T) {
+	f := BytesFormatter{Precision: 2, Base: Decimal1000}
+	got, err := f.Format(big.NewInt(1500000000000))
+	want := "1.50TB"
+	if err != nil || got != want {
+		t.Errorf("BytesFormatter{...}.Format(1500000000000)\n"+
+			"    return %q, %v\n"+
+			"    wanted %q, nil\n",
+			got, err, want)
+	}
+}
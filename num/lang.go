@@ -0,0 +1,564 @@
+package num
+
+import (
+	"fmt"
+	"strings"
+
+	"golang.org/x/text/language"
+)
+
+/*
+Speller renders an integer as the words of a particular
+language, in both its cardinal ("twenty-three") and ordinal
+("twenty-third") forms. Third parties can support additional
+languages, or override one of the built-in spellers, via
+RegisterSpeller.
+*/
+type Speller interface {
+	Word(n int) string
+	Ordinal(n int) string
+}
+
+var spellers = map[language.Tag]Speller{
+	language.English: EnglishSpeller{},
+	language.French:  FrenchSpeller{},
+	language.German:  GermanSpeller{},
+	language.Spanish: SpanishSpeller{},
+}
+
+/*
+RegisterSpeller makes s the Speller used for lang by WordIn
+and Ordinal. It allows third parties to add languages this
+package does not ship with, or to override one of the
+built-in spellers.
+*/
+func RegisterSpeller(lang language.Tag, s Speller) {
+	spellers[lang] = s
+}
+
+func spellerFor(lang language.Tag) (Speller, bool) {
+	if s, ok := spellers[lang]; ok {
+		return s, true
+	}
+
+	// Fall back to a Speller registered for the same base
+	// language - e.g. a request for fr-CA should still find
+	// the Speller registered under language.French. Skip the
+	// fallback when Base can't identify a language with high
+	// confidence (e.g. language.Und), since Base otherwise
+	// guesses "en" for tags like that.
+	base, conf := lang.Base()
+	if conf < language.High {
+		return nil, false
+	}
+	for tag, s := range spellers {
+		if tagBase, _ := tag.Base(); tagBase == base {
+			return s, true
+		}
+	}
+
+	return nil, false
+}
+
+/*
+WordIn takes n and returns a rendition of it in the language
+denoted by lang, using whichever Speller is registered for
+that language. Returns an error if no Speller is registered
+for lang.
+
+	s, _ := WordIn(99, language.French)  // "quatre-vingt-dix-neuf"
+	s, _ = WordIn(122, language.German) // "einhundertzweiundzwanzig"
+*/
+func WordIn(n int, lang language.Tag) (string, error) {
+	s, ok := spellerFor(lang)
+	if !ok {
+		return "", fmt.Errorf("no Speller registered for language %q", lang)
+	}
+	return s.Word(n), nil
+}
+
+/*
+Ordinal takes n and returns its ordinal rendition in the
+language denoted by lang - e.g. "first", "twenty-third". If
+no Speller is registered for lang it returns an empty string.
+*/
+func Ordinal(n int, lang language.Tag) string {
+	s, ok := spellerFor(lang)
+	if !ok {
+		return ""
+	}
+	return s.Ordinal(n)
+}
+
+/*
+EnglishSpeller renders integers as English words. It is the
+Speller registered for language.English and backs Word.
+*/
+type EnglishSpeller struct{}
+
+var englishUnits = []struct {
+	number int
+	word   string
+}{
+	{1000000000000000000, "quintillion"},
+	{1000000000000000, "quadrillion"},
+	{1000000000000, "trillion"},
+	{1000000000, "billion"},
+	{1000000, "million"},
+	{1000, "thousand"},
+	{100, "hundred"},
+	{90, "ninety"},
+	{80, "eighty"},
+	{70, "seventy"},
+	{60, "sixty"},
+	{50, "fifty"},
+	{40, "forty"},
+	{30, "thirty"},
+	{20, "twenty"},
+	{19, "nineteen"},
+	{18, "eighteen"},
+	{17, "seventeen"},
+	{16, "sixteen"},
+	{15, "fifteen"},
+	{14, "fourteen"},
+	{13, "thirteen"},
+	{12, "twelve"},
+	{11, "eleven"},
+	{10, "ten"},
+	{9, "nine"},
+	{8, "eight"},
+	{7, "seven"},
+	{6, "six"},
+	{5, "five"},
+	{4, "four"},
+	{3, "three"},
+	{2, "two"},
+	{1, "one"},
+}
+
+func (e EnglishSpeller) Word(n int) string {
+
+	if n == 0 {
+		return "zero"
+	}
+
+	var s string
+	var negative bool
+	if n < 0 {
+		negative = true
+		n = -n
+	}
+
+	for _, u := range englishUnits {
+
+		instances := n / u.number
+		n %= u.number
+
+		if instances == 0 {
+			continue
+		}
+
+		if len(s) > 0 && !strings.HasSuffix(s, "-") {
+			if u.number < 100 {
+				s += " and"
+			}
+			s += " "
+		}
+
+		if instances == 1 {
+			if u.number >= 100 {
+				s += "one "
+			}
+			s += u.word
+			if u.number < 100 && n > 0 {
+				s += "-"
+			}
+			continue
+		}
+
+		s += e.Word(instances) + " " + u.word
+	}
+
+	if negative {
+		s = "negative " + s
+	}
+
+	return s
+}
+
+var englishOrdinalWords = map[string]string{
+	"zero": "zeroth", "one": "first", "two": "second", "three": "third",
+	"four": "fourth", "five": "fifth", "six": "sixth", "seven": "seventh",
+	"eight": "eighth", "nine": "ninth", "ten": "tenth", "eleven": "eleventh",
+	"twelve": "twelfth", "thirteen": "thirteenth", "fourteen": "fourteenth",
+	"fifteen": "fifteenth", "sixteen": "sixteenth", "seventeen": "seventeenth",
+	"eighteen": "eighteenth", "nineteen": "nineteenth",
+}
+
+func (e EnglishSpeller) Ordinal(n int) string {
+	word := e.Word(n)
+
+	// Ordinal forms only change the word's final component -
+	// e.g. "twenty-three" becomes "twenty-third" - so split on
+	// the last hyphen or space and only transform that piece.
+	sep := strings.LastIndexAny(word, " -")
+	prefix, last := "", word
+	if sep >= 0 {
+		prefix, last = word[:sep+1], word[sep+1:]
+	}
+
+	if o, ok := englishOrdinalWords[last]; ok {
+		return prefix + o
+	}
+	if strings.HasSuffix(last, "y") {
+		return prefix + strings.TrimSuffix(last, "y") + "ieth"
+	}
+	return prefix + last + "th"
+}
+
+/*
+FrenchSpeller renders integers as French words, including the
+vigesimal quirks of the 70-99 range (e.g. "quatre-vingt-dix-neuf"
+for 99). It supports magnitudes up to 999,999,999,999.
+*/
+type FrenchSpeller struct{}
+
+var frenchUnits = [...]string{
+	"zéro", "un", "deux", "trois", "quatre", "cinq", "six", "sept", "huit", "neuf",
+	"dix", "onze", "douze", "treize", "quatorze", "quinze", "seize",
+}
+
+var frenchTens = map[int]string{
+	20: "vingt", 30: "trente", 40: "quarante", 50: "cinquante", 60: "soixante",
+}
+
+func frenchUnder100(n int) string {
+	switch {
+	case n < 17:
+		return frenchUnits[n]
+	case n < 20:
+		return "dix-" + frenchUnits[n-10]
+	case n < 70:
+		tens, rest := (n/10)*10, n%10
+		base := frenchTens[tens]
+		switch rest {
+		case 0:
+			return base
+		case 1:
+			return base + "-et-un"
+		default:
+			return base + "-" + frenchUnder100(rest)
+		}
+	case n < 80:
+		rest := n - 60
+		if rest == 11 {
+			return "soixante-et-onze"
+		}
+		return "soixante-" + frenchUnder100(rest)
+	default:
+		rest := n - 80
+		if rest == 0 {
+			return "quatre-vingts"
+		}
+		return "quatre-vingt-" + frenchUnder100(rest)
+	}
+}
+
+func (f FrenchSpeller) Word(n int) string {
+
+	if n == 0 {
+		return "zéro"
+	}
+
+	var negative bool
+	if n < 0 {
+		negative = true
+		n = -n
+	}
+
+	var s string
+	switch {
+	case n >= 1000000000:
+		q, r := n/1000000000, n%1000000000
+		unit := "milliard"
+		if q > 1 {
+			unit += "s"
+		}
+		s = f.Word(q) + " " + unit
+		if r > 0 {
+			s += " " + f.Word(r)
+		}
+	case n >= 1000000:
+		q, r := n/1000000, n%1000000
+		unit := "million"
+		if q > 1 {
+			unit += "s"
+		}
+		s = f.Word(q) + " " + unit
+		if r > 0 {
+			s += " " + f.Word(r)
+		}
+	case n >= 1000:
+		q, r := n/1000, n%1000
+		var prefix string
+		if q > 1 {
+			prefix = f.Word(q) + " "
+		}
+		s = prefix + "mille"
+		if r > 0 {
+			s += " " + f.Word(r)
+		}
+	case n >= 100:
+		q, r := n/100, n%100
+		var prefix string
+		if q > 1 {
+			prefix = f.Word(q) + " "
+		}
+		s = prefix + "cent"
+		if r == 0 && q > 1 {
+			s += "s"
+		}
+		if r > 0 {
+			s += " " + frenchUnder100(r)
+		}
+	default:
+		s = frenchUnder100(n)
+	}
+
+	if negative {
+		s = "moins " + s
+	}
+
+	return s
+}
+
+// Ordinal follows French typographic convention of writing
+// ordinals as a digit sequence plus an abbreviation rather
+// than spelling them out, e.g. "1er", "2e", "21e". It always
+// uses the masculine abbreviation "er", never the feminine
+// "ère"; callers needing feminine agreement should post-process
+// the result, the same restriction Word documents for Spanish's
+// "uno"/"una".
+func (f FrenchSpeller) Ordinal(n int) string {
+	if n == 1 {
+		return "1er"
+	}
+	return fmt.Sprintf("%de", n)
+}
+
+/*
+GermanSpeller renders integers as German words, joining them
+into the single compound words German uses for numbers (e.g.
+"einhundertzweiundzwanzig" for 122). It supports magnitudes up
+to 999,999,999.
+*/
+type GermanSpeller struct{}
+
+var germanUnits = [...]string{
+	"null", "eins", "zwei", "drei", "vier", "fünf", "sechs", "sieben", "acht", "neun",
+	"zehn", "elf", "zwölf", "dreizehn", "vierzehn", "fünfzehn", "sechzehn", "siebzehn", "achtzehn", "neunzehn",
+}
+
+var germanTens = map[int]string{
+	20: "zwanzig", 30: "dreißig", 40: "vierzig", 50: "fünfzig",
+	60: "sechzig", 70: "siebzig", 80: "achtzig", 90: "neunzig",
+}
+
+// germanUnder100 renders n (0-99) as German words. compound
+// is true when the result is followed by more of the word (as
+// the units digit is before "und"), which renders 1 as "ein"
+// instead of "eins", the form used when nothing follows.
+func germanUnder100(n int, compound bool) string {
+	if n < 20 {
+		if compound && n == 1 {
+			return "ein"
+		}
+		return germanUnits[n]
+	}
+	tens, rest := (n/10)*10, n%10
+	base := germanTens[tens]
+	if rest == 0 {
+		return base
+	}
+	return germanUnder100(rest, true) + "und" + base
+}
+
+func (g GermanSpeller) Word(n int) string {
+
+	if n == 0 {
+		return "null"
+	}
+
+	var negative bool
+	if n < 0 {
+		negative = true
+		n = -n
+	}
+
+	var s string
+	switch {
+	case n >= 1000000:
+		q, r := n/1000000, n%1000000
+		unit, qWord := "Million", "eine"
+		if q != 1 {
+			unit, qWord = "Millionen", g.Word(q)
+		}
+		s = qWord + " " + unit
+		if r > 0 {
+			s += " " + g.Word(r)
+		}
+	case n >= 1000:
+		q, r := n/1000, n%1000
+		prefix := "ein"
+		if q != 1 {
+			prefix = g.Word(q)
+		}
+		s = prefix + "tausend"
+		if r > 0 {
+			s += g.Word(r)
+		}
+	case n >= 100:
+		q, r := n/100, n%100
+		prefix := "ein"
+		if q != 1 {
+			prefix = g.Word(q)
+		}
+		s = prefix + "hundert"
+		if r > 0 {
+			s += germanUnder100(r, false)
+		}
+	default:
+		s = germanUnder100(n, false)
+	}
+
+	if negative {
+		s = "minus " + s
+	}
+
+	return s
+}
+
+// Ordinal follows the German convention of writing ordinals
+// as a digit sequence followed by a period, e.g. "1.", "22.".
+func (g GermanSpeller) Ordinal(n int) string {
+	return fmt.Sprintf("%d.", n)
+}
+
+/*
+SpanishSpeller renders integers as Spanish words. Word always
+uses the masculine cardinal form (e.g. "uno" rather than
+"una"); callers needing feminine agreement should post-process
+the result. It supports magnitudes up to 999,999,999.
+*/
+type SpanishSpeller struct{}
+
+var spanishUnits = [...]string{
+	"cero", "uno", "dos", "tres", "cuatro", "cinco", "seis", "siete", "ocho", "nueve",
+	"diez", "once", "doce", "trece", "catorce", "quince",
+}
+
+var spanishTeens = map[int]string{
+	16: "dieciséis", 17: "diecisiete", 18: "dieciocho", 19: "diecinueve",
+}
+
+var spanishTwenties = map[int]string{
+	21: "veintiuno", 22: "veintidós", 23: "veintitrés", 24: "veinticuatro",
+	25: "veinticinco", 26: "veintiséis", 27: "veintisiete", 28: "veintiocho", 29: "veintinueve",
+}
+
+var spanishTens = map[int]string{
+	30: "treinta", 40: "cuarenta", 50: "cincuenta",
+	60: "sesenta", 70: "setenta", 80: "ochenta", 90: "noventa",
+}
+
+func spanishUnder100(n int) string {
+	switch {
+	case n < 16:
+		return spanishUnits[n]
+	case n < 20:
+		return spanishTeens[n]
+	case n == 20:
+		return "veinte"
+	case n < 30:
+		return spanishTwenties[n]
+	default:
+		tens, rest := (n/10)*10, n%10
+		base := spanishTens[tens]
+		if rest == 0 {
+			return base
+		}
+		return base + " y " + spanishUnder100(rest)
+	}
+}
+
+var spanishHundreds = map[int]string{
+	2: "doscientos", 3: "trescientos", 4: "cuatrocientos", 5: "quinientos",
+	6: "seiscientos", 7: "setecientos", 8: "ochocientos", 9: "novecientos",
+}
+
+func (s SpanishSpeller) Word(n int) string {
+
+	if n == 0 {
+		return "cero"
+	}
+
+	var negative bool
+	if n < 0 {
+		negative = true
+		n = -n
+	}
+
+	var out string
+	switch {
+	case n >= 1000000:
+		q, r := n/1000000, n%1000000
+		unit, qWord := "millón", "un"
+		if q != 1 {
+			unit, qWord = "millones", s.Word(q)
+		}
+		out = qWord + " " + unit
+		if r > 0 {
+			out += " " + s.Word(r)
+		}
+	case n >= 1000:
+		q, r := n/1000, n%1000
+		var prefix string
+		if q > 1 {
+			prefix = s.Word(q) + " "
+		}
+		out = prefix + "mil"
+		if r > 0 {
+			out += " " + s.Word(r)
+		}
+	case n >= 100:
+		q, r := n/100, n%100
+		var base string
+		switch {
+		case n == 100:
+			base = "cien"
+		case q == 1:
+			base = "ciento"
+		default:
+			base = spanishHundreds[q]
+		}
+		out = base
+		if r > 0 {
+			out += " " + spanishUnder100(r)
+		}
+	default:
+		out = spanishUnder100(n)
+	}
+
+	if negative {
+		out = "menos " + out
+	}
+
+	return out
+}
+
+// Ordinal follows the common Spanish convention of writing
+// ordinals as a digit sequence followed by a masculine "º"
+// abbreviation, e.g. "1º", "22º".
+func (s SpanishSpeller) Ordinal(n int) string {
+	return fmt.Sprintf("%dº", n)
+}
@@ -0,0 +1,180 @@
+package num
+
+import (
+	"testing"
+
+	"golang.org/x/text/language"
+)
+
+func TestEnglishSpellerWord(t *testing.T) {
+
+	cases := []struct {
+		n    int
+		want string
+	}{
+		{0, "zero"},
+		{7232, "seven thousand two hundred and thirty-two"},
+		{-5, "negative five"},
+		{40, "forty"},
+		{42, "forty-two"},
+	}
+
+	for _, c := range cases {
+		if got := (EnglishSpeller{}).Word(c.n); got != c.want {
+			t.Errorf("EnglishSpeller{}.Word(%d)\n"+
+				"    return %q\n"+
+				"    wanted %q\n",
+				c.n, got, c.want)
+		}
+	}
+}
+
+func TestEnglishSpellerOrdinal(t *testing.T) {
+
+	cases := []struct {
+		n    int
+		want string
+	}{
+		{1, "first"},
+		{23, "twenty-third"},
+		{20, "twentieth"},
+		{40, "fortieth"},
+	}
+
+	for _, c := range cases {
+		if got := (EnglishSpeller{}).Ordinal(c.n); got != c.want {
+			t.Errorf("EnglishSpeller{}.Ordinal(%d)\n"+
+				"    return %q\n"+
+				"    wanted %q\n",
+				c.n, got, c.want)
+		}
+	}
+}
+
+func TestFrenchSpellerWord(t *testing.T) {
+
+	cases := []struct {
+		n    int
+		want string
+	}{
+		{0, "zéro"},
+		{21, "vingt-et-un"},
+		{70, "soixante-dix"},
+		{71, "soixante-et-onze"},
+		{80, "quatre-vingts"},
+		{81, "quatre-vingt-un"},
+		{99, "quatre-vingt-dix-neuf"},
+		{100, "cent"},
+		{200, "deux cents"},
+		{1000, "mille"},
+	}
+
+	for _, c := range cases {
+		if got := (FrenchSpeller{}).Word(c.n); got != c.want {
+			t.Errorf("FrenchSpeller{}.Word(%d)\n"+
+				"    return %q\n"+
+				"    wanted %q\n",
+				c.n, got, c.want)
+		}
+	}
+}
+
+func TestGermanSpellerWord(t *testing.T) {
+
+	cases := []struct {
+		n    int
+		want string
+	}{
+		{0, "null"},
+		{22, "zweiundzwanzig"},
+		{100, "einhundert"},
+		{122, "einhundertzweiundzwanzig"},
+		{1000, "eintausend"},
+	}
+
+	for _, c := range cases {
+		if got := (GermanSpeller{}).Word(c.n); got != c.want {
+			t.Errorf("GermanSpeller{}.Word(%d)\n"+
+				"    return %q\n"+
+				"    wanted %q\n",
+				c.n, got, c.want)
+		}
+	}
+}
+
+func TestSpanishSpellerWord(t *testing.T) {
+
+	cases := []struct {
+		n    int
+		want string
+	}{
+		{0, "cero"},
+		{1, "uno"},
+		{16, "dieciséis"},
+		{21, "veintiuno"},
+		{100, "cien"},
+		{101, "ciento uno"},
+		{200, "doscientos"},
+	}
+
+	for _, c := range cases {
+		if got := (SpanishSpeller{}).Word(c.n); got != c.want {
+			t.Errorf("SpanishSpeller{}.Word(%d)\n"+
+				"    return %q\n"+
+				"    wanted %q\n",
+				c.n, got, c.want)
+		}
+	}
+}
+
+func TestWordIn(t *testing.T) {
+
+	cases := []struct {
+		n       int
+		lang    language.Tag
+		want    string
+		wantErr bool
+	}{
+		{99, language.French, "quatre-vingt-dix-neuf", false},
+		{122, language.German, "einhundertzweiundzwanzig", false},
+		{7232, language.Und, "", true},
+	}
+
+	for _, c := range cases {
+		if got, err := WordIn(c.n, c.lang); got != c.want || err == nil && c.wantErr {
+
+			errStr := "nil"
+			if c.wantErr {
+				errStr = "error"
+			}
+
+			t.Errorf("WordIn(%d, %v)\n"+
+				"    return %q, %v\n"+
+				"    wanted %q, %s\n",
+				c.n, c.lang, got, err, c.want, errStr)
+		}
+	}
+}
+
+func TestOrdinal(t *testing.T) {
+
+	cases := []struct {
+		n    int
+		lang language.Tag
+		want string
+	}{
+		{1, language.English, "first"},
+		{23, language.English, "twenty-third"},
+		{1, language.French, "1er"},
+		{1, language.Und, ""},
+	}
+
+	for _, c := range cases {
+		if got := Ordinal(c.n, c.lang); got != c.want {
+			t.Errorf("Ordinal(%d, %v)\n"+
+				"    return %q\n"+
+				"    wanted %q\n",
+				c.n, c.lang, got, c.want)
+		}
+	}
+}
@@ -8,6 +8,8 @@ import (
 	"errors"
 	"fmt"
 	"math"
+	"math/big"
+	"strconv"
 	"strings"
 )
 
@@ -32,6 +34,10 @@ In short, Bytes is not intended to be highly precise. Its output
 is intended to be read by users performing tasks such as uploading
 images.
 
+See BytesFormatter for a configurable alternative that supports
+IEC/long suffixes, a decimal base, fixed precision, and locale-aware
+formatting.
+
 	println(num.Bytes(70000000000)) // 65.2GB
 	println(num.Bytes(6000000000))  // 5.6GB
 	println(num.Bytes(500000000))   // 476.8MB
@@ -118,160 +124,270 @@ func Roman(n int) (string, error) {
 	return s, nil
 }
 
+/*
+RomanBig converts n to a Roman numeral of type string,
+extending Roman to numbers too large to render sensibly as
+a handful of repeated "M" characters.
+
+Values of 4000 and above use the standard vinculum convention:
+a numeral multiplied by 1000 is marked with an overline,
+rendered here by inserting the combining overline character
+(U+0305) after each letter of the multiplied group. So 5000
+is V with an overline, 10000 is X with an overline, and one
+million (1000 multiplied by 1000) is M with an overline. When
+ascii is true the multiplied group is bracketed instead, so
+one million renders as "(M)", for contexts that can't display
+combining characters.
+
+	s, _ := RomanBig(big.NewInt(0), false)      // Error; no Roman numeral for zero.
+	s, _ = RomanBig(big.NewInt(1991), false)    // "MCMXCI"
+	s, _ = RomanBig(big.NewInt(1000000), true)  // "(M)"
+
+*/
+func RomanBig(n *big.Int, ascii bool) (string, error) {
+
+	if n.Sign() == 0 {
+		return "", errors.New("Input cannot be 0.")
+	}
+
+	if n.Sign() < 0 {
+		return "", fmt.Errorf("Input cannot be a negative number. Got %s.", n.String())
+	}
+
+	const vinculumThreshold = 4000
+	if n.IsInt64() && n.Int64() < vinculumThreshold {
+		return Roman(int(n.Int64()))
+	}
+
+	thousand := big.NewInt(1000)
+	quotient := new(big.Int)
+	remainder := new(big.Int)
+	quotient.QuoRem(n, thousand, remainder)
+
+	/*
+		The quotient is n divided by 1000, so we reuse Roman's
+		own greedy subtractive algorithm (via RomanBig again,
+		which falls back to Roman once it's small enough and
+		otherwise nests another vinculum) to render it before
+		marking it as multiplied by 1000.
+	*/
+	qStr, err := RomanBig(quotient, ascii)
+	if err != nil {
+		return "", err
+	}
+
+	var rStr string
+	if remainder.Sign() != 0 {
+		rStr, err = Roman(int(remainder.Int64()))
+		if err != nil {
+			return "", err
+		}
+	}
+
+	if ascii {
+		return "(" + qStr + ")" + rStr, nil
+	}
+	return overline(qStr) + rStr, nil
+}
+
+// overline marks s as multiplied by 1000 under the vinculum
+// convention by appending a combining overline (U+0305) after
+// every letter it contains.
+func overline(s string) string {
+	const combiningOverline = '̅'
+	var b strings.Builder
+	for _, r := range s {
+		b.WriteRune(r)
+		if r != combiningOverline {
+			b.WriteRune(combiningOverline)
+		}
+	}
+	return b.String()
+}
+
+/*
+WordFloat takes f and returns its English rendition, spelling
+the whole part via Word and, if precision is positive, the
+fractional part digit-by-digit after "point" to precision
+decimal places.
+
+f is first formatted to an exact decimal string via
+strconv.AppendFloat and rendered from that, rather than peeled
+apart with repeated multiplication by 10, which accumulates
+binary floating-point error - the same "convert to an exact
+decimal, then render digit-by-digit" approach math/big's ftoa
+uses. See WordDecimal to render a pre-formatted decimal string
+directly, bypassing float64 altogether.
+
+	WordFloat(3.14, 2) // "three point one four"
+	WordFloat(0.1, 3)  // "zero point one zero zero"
+
+NaN and the infinities have no sensible English rendition, so
+they are returned as strconv formats them ("NaN", "+Inf",
+"-Inf") rather than being passed through WordDecimal.
+*/
 func WordFloat(f float64, precision int) string {
+	if math.IsNaN(f) || math.IsInf(f, 0) {
+		return strconv.FormatFloat(f, 'f', -1, 64)
+	}
+	if precision < 0 {
+		precision = 0
+	}
+	s := strconv.AppendFloat(nil, f, 'f', precision, 64)
+	word, err := WordDecimal(string(s))
+	if err != nil {
+		// Unreachable: strconv.AppendFloat with the 'f' verb
+		// always produces a fixed-decimal string WordDecimal
+		// can parse.
+		return string(s)
+	}
+	return word
+}
 
-	// Get words for whole number then remove
-	// that part of the number.
-	s := Word(int(f))
-	f -= float64(int(f))
+/*
+WordDecimal takes s, a decimal number formatted like "3.14" or
+"-0.5", and returns its English rendition, spelling the whole
+part via WordBig and the fractional part digit-by-digit after
+"point". The whole part is parsed as a big.Int, so it lets
+callers holding a *big.Float or a shopspring/decimal value
+render it without ever converting through float64 or being
+capped at int's range. Returns an error if s is not a valid
+decimal number.
+
+	s, _ := WordDecimal("3.14159") // "three point one four one five nine"
+	s, _ = WordDecimal("-0.5")     // "negative zero point five"
+	s, _ = WordDecimal("abc")      // Error; not a valid decimal number.
+
+	s, _ = WordDecimal("10000000000000000000") // "ten quintillion"
+*/
+func WordDecimal(s string) (string, error) {
 
-	if precision > 0 {
-		s += " point"
+	negative := strings.HasPrefix(s, "-")
+	if negative {
+		s = s[1:]
 	}
 
-	for i := 0; i < precision; i++ {
+	whole, frac, hasFrac := strings.Cut(s, ".")
+	if whole == "" || !isDigits(whole) || (hasFrac && (frac == "" || !isDigits(frac))) {
+		return "", fmt.Errorf("%q is not a valid decimal number.", s)
+	}
 
-		// Multiply fractional part til first number is a whole number.
-		n := int(f * 10)
+	n, ok := new(big.Int).SetString(whole, 10)
+	if !ok {
+		return "", fmt.Errorf("%q is not a valid decimal number.", s)
+	}
 
-		// Remove the first number from fractional part.
-		f = f*10 - float64(n)
+	word := WordBig(n)
+	if negative {
+		word = "negative " + word
+	}
 
-		// Add word for first number in fractional part.
-		s += " " + Word(n)
+	if hasFrac {
+		word += " point"
+		for _, r := range frac {
+			word += " " + Word(int(r-'0'))
+		}
 	}
 
-	return s
+	return word, nil
+}
+
+// isDigits reports whether s consists entirely of the digits
+// '0' through '9'.
+func isDigits(s string) bool {
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
 }
 
 /*
 Word takes n and returns an English language rendition of it.
+It is a thin wrapper around EnglishSpeller, the Speller used
+for language.English; see WordIn to render n in another
+registered language.
 
 	Word(7232) // "seven thousand two hundred and thirty-two"
 	Word(-5)   // "negative five"
 
 */
 func Word(n int) string {
+	return EnglishSpeller{}.Word(n)
+}
 
-	// Zero screws with our logic so we handle it here.
-	if n == 0 {
+// bigScales names the short-scale groups of three digits used
+// by WordBig, indexed by how many groups of three digits sit
+// to their right (0 for the units group, 1 for "thousand",
+// and so on up through "decillion").
+var bigScales = []string{
+	"", "thousand", "million", "billion", "trillion",
+	"quadrillion", "quintillion", "sextillion", "septillion",
+	"octillion", "nonillion", "decillion",
+}
+
+/*
+WordBig takes n and returns an English language rendition of
+it, extending Word to integers too large to fit in an int.
+
+	WordBig(big.NewInt(7232))                        // "seven thousand two hundred and thirty-two"
+	WordBig(new(big.Int).Exp(big.NewInt(10), big.NewInt(21), nil)) // "one sextillion"
+*/
+func WordBig(n *big.Int) string {
+
+	if n.Sign() == 0 {
 		return "zero"
 	}
 
-	/*
-		We have to record n's sign here because we
-		modify n below. We also force it to be a
-		positive number so we can use the same logic
-		for negative or positive.
-	*/
-	var s string
-	var negative bool
-	if n < 0 {
-		negative = true
-		n = -n
+	// Word already handles anything that fits in an int, so
+	// only fall through to the big.Int path for larger values.
+	if n.IsInt64() {
+		return Word(int(n.Int64()))
 	}
 
-	type unit struct {
-		number int
-		word   string
-	}
-	units := []unit{
-		{1000000000, "billion"},
-		{1000000, "million"},
-		{1000, "thousand"},
-		{100, "hundred"},
-		{90, "ninety"},
-		{80, "eighty"},
-		{70, "seventy"},
-		{60, "sixty"},
-		{50, "fifty"},
-		{40, "fourty"},
-		{30, "thirty"},
-		{20, "twenty"},
-		{19, "nineteen"},
-		{18, "eighteen"},
-		{17, "seventeen"},
-		{16, "sixteen"},
-		{15, "fifteen"},
-		{14, "fourteen"},
-		{13, "thirteen"},
-		{12, "twelve"},
-		{11, "eleven"},
-		{10, "ten"},
-		{9, "nine"},
-		{8, "eight"},
-		{7, "seven"},
-		{6, "six"},
-		{5, "five"},
-		{4, "four"},
-		{3, "three"},
-		{2, "two"},
-		{1, "one"},
-	}
-
-	for _, u := range units {
-
-		instances := n / u.number
-		n %= u.number
-
-		if instances == 0 {
-			continue
-		}
+	negative := n.Sign() < 0
+	digits := new(big.Int).Abs(n).String()
 
-		/*
-			If we've already got preceding words and there's
-			no trailing hyphen we should add "and" before
-			numbers less than 100 - e.g. two hundred and five,
-			six thousand and eighty-four, etc. Regardless, we
-			always add a trailing space.
-		*/
-		if len(s) > 0 && !strings.HasSuffix(s, "-") {
-			if u.number < 100 {
-				s += " and"
-			}
-			s += " "
+	var groups []int
+	for len(digits) > 0 {
+		cut := len(digits) - 3
+		if cut < 0 {
+			cut = 0
 		}
+		group, _ := strconv.Atoi(digits[cut:])
+		groups = append([]int{group}, groups...)
+		digits = digits[:cut]
+	}
 
-		if instances == 1 {
+	var parts []string
+	for i, g := range groups {
+		if g == 0 {
+			continue
+		}
 
-			/*
-				Single instances of "hundred" and greater units
-				("thousand", etc) need to be prefixed with "one"
-				- e.g. one hundred, one thousand, etc.
-			*/
-			if u.number >= 100 {
-				s += "one "
-			}
+		scaleIdx := len(groups) - 1 - i
+		w := wordGroup(g)
 
-			// Add the actual word.
-			s += u.word
+		// Mirror Word's convention of inserting "and" before
+		// a trailing group under 100 when preceded by larger
+		// groups - e.g. "one thousand and eighty-four".
+		if scaleIdx == 0 && g < 100 && len(parts) > 0 {
+			w = "and " + w
+		}
 
-			/*
-				If there's still more of n left and the number
-				we're currently dealing with is less than 100
-				we need a hyphen - e.g. sixty-nine.
-			*/
-			if u.number < 100 && n > 0 {
-				s += "-"
+		if scaleIdx > 0 {
+			scale := "(unnamed scale)"
+			if scaleIdx < len(bigScales) {
+				scale = bigScales[scaleIdx]
 			}
-
-			continue
+			w += " " + scale
 		}
 
-		/*
-			If there are multiple instances of the unit number -
-			e.g. in 2,400,000 there are two instances of the unit
-			"million" - we recurse to get the word for the number
-			of instances.
-		*/
-		s += Word(instances) + " " + u.word
+		parts = append(parts, w)
 	}
 
-	/*
-		We prefix "negative" right before returning
-		otherwise it messes with the conditionals
-		that decide when to add "and" between words.
-	*/
+	s := strings.Join(parts, " ")
 	if negative {
 		s = "negative " + s
 	}
@@ -279,6 +395,26 @@ func Word(n int) string {
 	return s
 }
 
+// wordGroup renders n, which must be in the range 0-999, as
+// the hundreds/tens/ones portion of a larger number assembled
+// by WordBig.
+func wordGroup(n int) string {
+	hundreds := n / 100
+	rest := n % 100
+
+	var s string
+	if hundreds > 0 {
+		s = Word(hundreds) + " hundred"
+	}
+	if rest > 0 {
+		if s != "" {
+			s += " and "
+		}
+		s += Word(rest)
+	}
+	return s
+}
+
 /*
 Alpha converts n to a base 52 string where each numeral
 is represented by an upper or lower case alphabet character.
@@ -371,6 +507,296 @@ func Encode(n int, encoding string) (string, error) {
 	return result, nil
 }
 
+/*
+EncodeGrouped converts n to a string using the characters in
+encoding as its numerals, just like Encode, but inserts sep
+after every groupSize symbols, counted from the right, to make
+longer values easier to read. Returns an error under the same
+conditions as Encode.
+
+	s, _ := EncodeGrouped(1234567, "0123456789", ",", 3) // "1,234,567"
+
+The grouped form round-trips losslessly: passing the same sep
+to Decode strips it back out.
+
+	n, _ := Decode("1,234,567", "0123456789", ",") // 1234567
+*/
+func EncodeGrouped(n int, encoding, sep string, groupSize int) (string, error) {
+
+	s, err := Encode(n, encoding)
+	if err != nil {
+		return "", err
+	}
+
+	if sep == "" || groupSize <= 0 {
+		return s, nil
+	}
+
+	symbols := strings.Split(s, "")
+	var grouped strings.Builder
+	for i, sym := range symbols {
+		if i > 0 && (len(symbols)-i)%groupSize == 0 {
+			grouped.WriteString(sep)
+		}
+		grouped.WriteString(sym)
+	}
+
+	return grouped.String(), nil
+}
+
+/*
+EncodeBig converts n to a string that uses the characters in
+encoding as its numerals, just like Encode but for integers
+too large to fit in an int.
+
+	s, _ := EncodeBig(big.NewInt(-1), "0123456789") // Error; n is negative.
+	s, _ = EncodeBig(big.NewInt(298648), "0123456789") // "298648"
+*/
+func EncodeBig(n *big.Int, encoding string) (string, error) {
+
+	if n.Sign() < 0 {
+		return "", fmt.Errorf("Input number cannot be negative. Got %s", n.String())
+	}
+
+	if encoding == "" {
+		return "", errors.New("Encoding cannot be an empty string.")
+	}
+
+	enc := strings.Split(encoding, "")
+	if err := uniqueSet(enc); err != nil {
+		return "", err
+	}
+	if n.Sign() == 0 {
+		return enc[0], nil
+	}
+
+	length := len(enc)
+	if length == 1 {
+		return "", errors.New("Encoding must have at least two characters.")
+	}
+
+	base := big.NewInt(int64(length))
+	quotient := new(big.Int).Set(n)
+	remainder := new(big.Int)
+
+	var result string
+	for quotient.Sign() != 0 {
+		quotient.QuoRem(quotient, base, remainder)
+		result = enc[remainder.Int64()] + result
+	}
+
+	return result, nil
+}
+
+/*
+Decode converts s back into the integer it represents under
+encoding, inverting Encode. Returns an error under the same
+conditions as Encode, plus when s is empty or contains a
+character that is not present in encoding.
+
+An optional sep, as produced by EncodeGrouped, may be given to
+have Decode ignore digit-group separators. A single sep
+between two valid symbols is skipped; a leading, trailing, or
+doubled sep is an error, matching the invariants Go itself
+enforces for "_" in numeric literals.
+
+	n, _ := Decode("", "0123456789")     // Error; s is empty.
+	n, _ = Decode("Z", "0123456789")     // Error; "Z" is not in the encoding.
+
+	n, _ = Decode("0", "0123456789")  // 0
+	n, _ = Decode("1", "0123456789")  // 1
+	n, _ = Decode("10", "0123456789") // 10
+
+	n, _ = Decode("ä¸–", "ä¸–ç•Œ") // 0
+	n, _ = Decode("ç•Œ", "ä¸–ç•Œ") // 1
+
+	n, _ = Decode("&*%#*", "!@#$%^&*()") // 67427
+
+	n, _ = Decode("1,234,567", "0123456789", ",") // 1234567
+	n, _ = Decode(",1234567", "0123456789", ",")  // Error; leading separator.
+	n, _ = Decode("1,,234567", "0123456789", ",")  // Error; doubled separator.
+*/
+func Decode(s, encoding string, sep ...string) (int, error) {
+
+	if encoding == "" {
+		return 0, errors.New("Encoding cannot be an empty string.")
+	}
+
+	enc := strings.Split(encoding, "")
+	if err := uniqueSet(enc); err != nil {
+		return 0, err
+	}
+
+	length := len(enc)
+	if length == 1 {
+		return 0, errors.New("Encoding must have at least two characters.")
+	}
+
+	if s == "" {
+		return 0, errors.New("Input string cannot be an empty string.")
+	}
+
+	if len(sep) > 0 && sep[0] != "" {
+		stripped, err := stripGroupSeparator(s, sep[0])
+		if err != nil {
+			return 0, err
+		}
+		s = stripped
+	}
+
+	index := make(map[string]int, length)
+	for i, e := range enc {
+		index[e] = i
+	}
+
+	var n int
+	for _, r := range strings.Split(s, "") {
+		v, ok := index[r]
+		if !ok {
+			return 0, fmt.Errorf("%q is not a character in the given encoding.", r)
+		}
+		n = n*length + v
+	}
+
+	return n, nil
+}
+
+// stripGroupSeparator removes every occurrence of sep from s,
+// rejecting a leading, trailing, or doubled sep along the way.
+func stripGroupSeparator(s, sep string) (string, error) {
+	if strings.HasPrefix(s, sep) || strings.HasSuffix(s, sep) {
+		return "", fmt.Errorf("%q cannot start or end with the separator %q.", s, sep)
+	}
+	groups := strings.Split(s, sep)
+	for _, g := range groups {
+		if g == "" {
+			return "", fmt.Errorf("%q contains a doubled separator %q.", s, sep)
+		}
+	}
+	return strings.Join(groups, ""), nil
+}
+
+/*
+DecodeAlpha converts s back into the integer it represents,
+inverting Alpha.
+
+	n, _ := DecodeAlpha("A")  // 0
+	n, _ = DecodeAlpha("Z")   // 25
+	n, _ = DecodeAlpha("BA")  // 52
+*/
+func DecodeAlpha(s string) (int, error) {
+	const encoding = "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz"
+	return Decode(s, encoding)
+}
+
+/*
+DecodeBig converts s back into the integer it represents
+under encoding, inverting EncodeBig.
+
+	n, _ := DecodeBig("298648", "0123456789") // big.NewInt(298648)
+*/
+func DecodeBig(s, encoding string) (*big.Int, error) {
+
+	if encoding == "" {
+		return nil, errors.New("Encoding cannot be an empty string.")
+	}
+
+	enc := strings.Split(encoding, "")
+	if err := uniqueSet(enc); err != nil {
+		return nil, err
+	}
+
+	length := len(enc)
+	if length == 1 {
+		return nil, errors.New("Encoding must have at least two characters.")
+	}
+
+	if s == "" {
+		return nil, errors.New("Input string cannot be an empty string.")
+	}
+
+	index := make(map[string]int, length)
+	for i, e := range enc {
+		index[e] = i
+	}
+
+	base := big.NewInt(int64(length))
+	n := new(big.Int)
+	for _, r := range strings.Split(s, "") {
+		v, ok := index[r]
+		if !ok {
+			return nil, fmt.Errorf("%q is not a character in the given encoding.", r)
+		}
+		n.Mul(n, base)
+		n.Add(n, big.NewInt(int64(v)))
+	}
+
+	return n, nil
+}
+
+/*
+ParseRoman converts a Roman numeral string s back into the
+integer it represents, inverting Roman. Only the canonical
+subtractive form produced by Roman is accepted; malformed
+numerals such as "IIII" or "VV" return an error.
+
+	n, _ := ParseRoman("")      // Error; s is empty.
+	n, _ = ParseRoman("IIII")   // Error; not canonical, want "IV".
+	n, _ = ParseRoman("VV")     // Error; not canonical, want "X".
+
+	n, _ = ParseRoman("I")      // 1
+	n, _ = ParseRoman("IV")     // 4
+	n, _ = ParseRoman("CDXLII") // 442
+	n, _ = ParseRoman("MCMXCI") // 1991
+*/
+func ParseRoman(s string) (int, error) {
+
+	if s == "" {
+		return 0, errors.New("Input cannot be an empty string.")
+	}
+
+	type multiple struct {
+		number int
+		letter string
+	}
+	multiples := []multiple{
+		{1000, "M"}, {900, "CM"},
+		{500, "D"}, {400, "CD"},
+		{100, "C"}, {90, "XC"},
+		{50, "L"}, {40, "XL"},
+		{10, "X"}, {9, "IX"},
+		{5, "V"}, {4, "IV"},
+		{1, "I"},
+	}
+
+	rest := s
+	var n int
+	for _, m := range multiples {
+		for strings.HasPrefix(rest, m.letter) {
+			n += m.number
+			rest = rest[len(m.letter):]
+		}
+	}
+
+	if rest != "" {
+		return 0, fmt.Errorf("%q is not a valid Roman numeral.", s)
+	}
+
+	/*
+		Roman's greedy subtractive algorithm always produces a
+		canonical numeral for a given n, so re-encoding n and
+		comparing against s rejects non-canonical forms such
+		as "IIII" or "VV" without needing a separate set of
+		validation rules.
+	*/
+	canonical, err := Roman(n)
+	if err != nil || canonical != s {
+		return 0, fmt.Errorf("%q is not a valid Roman numeral.", s)
+	}
+
+	return n, nil
+}
+
 func uniqueSet(ss []string) error {
 	seen := make(map[string]bool, len(ss))
 	for _, s := range ss {
@@ -1,6 +1,8 @@
 package num
 
 import (
+	"math"
+	"math/big"
 	"testing"
 )
 
@@ -72,6 +74,379 @@ func TestAlpha(t *testing.T) {
 	}
 }
 
+func TestParseRoman(t *testing.T) {
+
+	cases := []struct {
+		s       string
+		want    int
+		wantErr bool
+	}{
+		{"", 0, true},
+		{"IIII", 0, true},
+		{"VV", 0, true},
+		{"IC", 0, true},
+		{"I", 1, false},
+		{"III", 3, false},
+		{"IV", 4, false},
+		{"V", 5, false},
+		{"IX", 9, false},
+		{"XI", 11, false},
+		{"CCXCIV", 294, false},
+		{"CDXLII", 442, false},
+		{"CM", 900, false},
+		{"MCMLXXXIX", 1989, false},
+		{"MMMMDCCCLIX", 4859, false},
+	}
+
+	for _, c := range cases {
+		if got, err := ParseRoman(c.s); got != c.want || err == nil && c.wantErr {
+
+			errStr := "nil"
+			if c.wantErr {
+				errStr = "error"
+			}
+
+			t.Errorf("ParseRoman(%q)\n"+
+				"    return %d, %v\n"+
+				"    wanted %d, %s\n",
+				c.s, got, err, c.want, errStr)
+		}
+	}
+}
+
+func TestDecodeAlpha(t *testing.T) {
+
+	cases := []struct {
+		s       string
+		want    int
+		wantErr bool
+	}{
+		{"", 0, true},
+		{"A", 0, false},
+		{"D", 3, false},
+		{"E", 4, false},
+		{"BA", 52, false},
+	}
+
+	for _, c := range cases {
+		if got, err := DecodeAlpha(c.s); got != c.want || err == nil && c.wantErr {
+
+			errStr := "nil"
+			if c.wantErr {
+				errStr = "error"
+			}
+
+			t.Errorf("DecodeAlpha(%q)\n"+
+				"    return %d, %v\n"+
+				"    wanted %d, %s\n",
+				c.s, got, err, c.want, errStr)
+		}
+	}
+}
+
+func TestDecode(t *testing.T) {
+
+	cases := []struct {
+		s       string
+		enc     string
+		want    int
+		wantErr bool
+	}{
+		{"", "0123456789", 0, true},
+		{"Z", "0123456789", 0, true},
+		{"5", "A", 0, true},
+
+		{"0", "0123456789", 0, false},
+		{"1", "0123456789", 1, false},
+		{"10", "0123456789", 10, false},
+		{"11", "0123456789", 11, false},
+		{"100", "0123456789", 100, false},
+		{"298648", "0123456789", 298648, false},
+
+		{"#", "!@#$%^&*()", 2, false},
+		{"@@", "!@#$%^&*()", 11, false},
+		{"))", "!@#$%^&*()", 99, false},
+		{"&*%#*", "!@#$%^&*()", 67427, false},
+	}
+
+	for _, c := range cases {
+		if got, err := Decode(c.s, c.enc); got != c.want || err == nil && c.wantErr {
+
+			errStr := "nil"
+			if c.wantErr {
+				errStr = "error"
+			}
+
+			t.Errorf("Decode(%q, %q)\n"+
+				"    return %d, %v\n"+
+				"    wanted %d, %s\n",
+				c.s, c.enc, got, err, c.want, errStr)
+		}
+	}
+}
+
+func TestEncodeGrouped(t *testing.T) {
+
+	cases := []struct {
+		n         int
+		enc       string
+		sep       string
+		groupSize int
+		want      string
+		wantErr   bool
+	}{
+		{-1, "0123456789", ",", 3, "", true},
+
+		{0, "0123456789", ",", 3, "0", false},
+		{1, "0123456789", ",", 3, "1", false},
+		{100, "0123456789", ",", 3, "100", false},
+		{1234567, "0123456789", ",", 3, "1,234,567", false},
+
+		{1234567, "0123456789", "", 3, "1234567", false},
+		{1234567, "0123456789", ",", 0, "1234567", false},
+
+		{67427, "!@#$%^&*()", "-", 2, "&-*%-#*", false},
+	}
+
+	for _, c := range cases {
+		got, err := EncodeGrouped(c.n, c.enc, c.sep, c.groupSize)
+		if got != c.want || err == nil && c.wantErr {
+
+			errStr := "nil"
+			if c.wantErr {
+				errStr = "error"
+			}
+
+			t.Errorf("EncodeGrouped(%d, %q, %q, %d)\n"+
+				"    return %q, %v\n"+
+				"    wanted %q, %s\n",
+				c.n, c.enc, c.sep, c.groupSize, got, err, c.want, errStr)
+		}
+	}
+}
+
+func TestDecodeGrouped(t *testing.T) {
+
+	cases := []struct {
+		s       string
+		enc     string
+		sep     string
+		want    int
+		wantErr bool
+	}{
+		{"1,234,567", "0123456789", ",", 1234567, false},
+		{",1234567", "0123456789", ",", 0, true},
+		{"1234567,", "0123456789", ",", 0, true},
+		{"1,,234567", "0123456789", ",", 0, true},
+		{"1234567", "0123456789", ",", 1234567, false},
+		{"&*-%#*", "!@#$%^&*()", "-", 67427, false},
+	}
+
+	for _, c := range cases {
+		got, err := Decode(c.s, c.enc, c.sep)
+		if got != c.want || err == nil && c.wantErr {
+
+			errStr := "nil"
+			if c.wantErr {
+				errStr = "error"
+			}
+
+			t.Errorf("Decode(%q, %q, %q)\n"+
+				"    return %d, %v\n"+
+				"    wanted %d, %s\n",
+				c.s, c.enc, c.sep, got, err, c.want, errStr)
+		}
+	}
+}
+
+func TestRomanBig(t *testing.T) {
+
+	cases := []struct {
+		n       int64
+		ascii   bool
+		want    string
+		wantErr bool
+	}{
+		{0, false, "", true},
+		{-1, false, "", true},
+		{1, false, "I", false},
+		{1991, false, "MCMXCI", false},
+		{3999, false, "MMMCMXCIX", false},
+		{4000, false, overline("IV"), false},
+		{4859, false, overline("IV") + "DCCCLIX", false},
+		{5000, false, overline("V"), false},
+		{10000, false, overline("X"), false},
+		{1000000, false, overline("M"), false},
+		{1000000, true, "(M)", false},
+	}
+
+	for _, c := range cases {
+		if got, err := RomanBig(big.NewInt(c.n), c.ascii); got != c.want || err == nil && c.wantErr {
+
+			errStr := "nil"
+			if c.wantErr {
+				errStr = "error"
+			}
+
+			t.Errorf("RomanBig(%d, %v)\n"+
+				"    return %q, %v\n"+
+				"    wanted %q, %s\n",
+				c.n, c.ascii, got, err, c.want, errStr)
+		}
+	}
+}
+
+func TestWordFloat(t *testing.T) {
+
+	cases := []struct {
+		f         float64
+		precision int
+		want      string
+	}{
+		{0, 0, "zero"},
+		{3.14, 2, "three point one four"},
+		{0.1, 3, "zero point one zero zero"},
+		{-0.5, 1, "negative zero point five"},
+		{7232, 0, "seven thousand two hundred and thirty-two"},
+		{7232, -1, "seven thousand two hundred and thirty-two"},
+		{1e19, 0, "ten quintillion"},
+		{math.NaN(), 2, "NaN"},
+		{math.Inf(1), 2, "+Inf"},
+		{math.Inf(-1), 2, "-Inf"},
+	}
+
+	for _, c := range cases {
+		if got := WordFloat(c.f, c.precision); got != c.want {
+			t.Errorf("WordFloat(%v, %d)\n"+
+				"    return %q\n"+
+				"    wanted %q\n",
+				c.f, c.precision, got, c.want)
+		}
+	}
+}
+
+func TestWordDecimal(t *testing.T) {
+
+	cases := []struct {
+		s       string
+		want    string
+		wantErr bool
+	}{
+		{"abc", "", true},
+		{"3.", "", true},
+		{".5", "", true},
+		{"3.14159", "three point one four one five nine", false},
+		{"-0.5", "negative zero point five", false},
+		{"100", "one hundred", false},
+		{"10000000000000000000", "ten quintillion", false},
+		{"-10000000000000000000.5", "negative ten quintillion point five", false},
+	}
+
+	for _, c := range cases {
+		if got, err := WordDecimal(c.s); got != c.want || err == nil && c.wantErr {
+
+			errStr := "nil"
+			if c.wantErr {
+				errStr = "error"
+			}
+
+			t.Errorf("WordDecimal(%q)\n"+
+				"    return %q, %v\n"+
+				"    wanted %q, %s\n",
+				c.s, got, err, c.want, errStr)
+		}
+	}
+}
+
+func TestWordBig(t *testing.T) {
+
+	sextillion := new(big.Int).Exp(big.NewInt(10), big.NewInt(21), nil)
+
+	cases := []struct {
+		n    *big.Int
+		want string
+	}{
+		{big.NewInt(0), "zero"},
+		{big.NewInt(7232), Word(7232)},
+		{big.NewInt(-7232), Word(-7232)},
+		{sextillion, "one sextillion"},
+	}
+
+	for _, c := range cases {
+		if got := WordBig(c.n); got != c.want {
+			t.Errorf("WordBig(%s)\n"+
+				"    return %q\n"+
+				"    wanted %q\n",
+				c.n.String(), got, c.want)
+		}
+	}
+}
+
+func TestEncodeBig(t *testing.T) {
+
+	cases := []struct {
+		n       int64
+		enc     string
+		want    string
+		wantErr bool
+	}{
+		{-1, "0123456789", "", true},
+		{5, "A", "", true},
+		{0, "0123456789", "0", false},
+		{10, "0123456789", "10", false},
+		{298648, "0123456789", "298648", false},
+		{67427, "!@#$%^&*()", "&*%#*", false},
+	}
+
+	for _, c := range cases {
+		if got, err := EncodeBig(big.NewInt(c.n), c.enc); got != c.want || err == nil && c.wantErr {
+
+			errStr := "nil"
+			if c.wantErr {
+				errStr = "error"
+			}
+
+			t.Errorf("EncodeBig(%d, %q)\n"+
+				"    return %q, %v\n"+
+				"    wanted %q, %s\n",
+				c.n, c.enc, got, err, c.want, errStr)
+		}
+	}
+}
+
+func TestDecodeBig(t *testing.T) {
+
+	cases := []struct {
+		s       string
+		enc     string
+		want    int64
+		wantErr bool
+	}{
+		{"", "0123456789", 0, true},
+		{"Z", "0123456789", 0, true},
+		{"0", "0123456789", 0, false},
+		{"10", "0123456789", 10, false},
+		{"298648", "0123456789", 298648, false},
+		{"&*%#*", "!@#$%^&*()", 67427, false},
+	}
+
+	for _, c := range cases {
+		got, err := DecodeBig(c.s, c.enc)
+		if c.wantErr {
+			if err == nil {
+				t.Errorf("DecodeBig(%q, %q) wanted an error, got nil", c.s, c.enc)
+			}
+			continue
+		}
+		if err != nil || got.Cmp(big.NewInt(c.want)) != 0 {
+			t.Errorf("DecodeBig(%q, %q)\n"+
+				"    return %v, %v\n"+
+				"    wanted %d, nil\n",
+				c.s, c.enc, got, err, c.want)
+		}
+	}
+}
+
 func TestEncode(t *testing.T) {
 
 	cases := []struct {